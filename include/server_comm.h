@@ -3,7 +3,7 @@
  *
  * Handles heartbeat communication with the APIS server:
  * - Periodic heartbeats every 60 seconds
- * - Reports device status (armed, uptime, storage, pending clips)
+ * - Reports device status (armed, uptime, device time, storage, pending clips)
  * - Receives config updates from server
  * - Handles offline mode gracefully
  *
@@ -147,6 +147,22 @@ static uint32_t get_uptime_seconds(void) {
     return (uint32_t)(time(NULL) - g_start_time);
 }
 
+/**
+ * Format the device's current wall-clock time as ISO 8601 UTC.
+ * Sent with each heartbeat so the server can detect RTC skew.
+ */
+static void format_device_time(char *buf, size_t buf_size) {
+    time_t now = time(NULL);
+    struct tm tm_buf;
+    struct tm *tm = gmtime_r(&now, &tm_buf);
+
+    if (tm != NULL) {
+        strftime(buf, buf_size, "%Y-%m-%dT%H:%M:%SZ", tm);
+    } else {
+        snprintf(buf, buf_size, "1970-01-01T00:00:00Z");
+    }
+}
+
 // Heartbeat always uses /api/units/heartbeat endpoint
 #define HEARTBEAT_PATH "/api/units/heartbeat"
 #define CLAIM_TOKEN_EXCHANGE_PATH "/api/units/claim-tokens/exchange"
@@ -414,6 +430,9 @@ static int do_heartbeat(heartbeat_response_t *resp) {
     cJSON_AddBoolToObject(req_json, "armed", config_local.armed);
     cJSON_AddStringToObject(req_json, "firmware_version", FIRMWARE_VERSION);
     cJSON_AddNumberToObject(req_json, "uptime_seconds", get_uptime_seconds());
+    char device_time[32];
+    format_device_time(device_time, sizeof(device_time));
+    cJSON_AddStringToObject(req_json, "device_time", device_time);
     // Get actual free storage from storage_manager
     uint32_t free_storage_mb = 0;
     if (storage_manager_is_initialized()) {
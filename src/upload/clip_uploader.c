@@ -691,16 +691,26 @@ static upload_status_t do_upload(clip_queue_entry_t *entry) {
     // Calculate total content length
     size_t content_length = (size_t)header_len + (size_t)file_size + (size_t)footer_len;
 
+    // The stable clip ID doubles as the idempotency key so the server can
+    // return the original response when a retry replays an upload.
+    char idempotency_header[CLIP_ID_MAX + 24] = "";
+    if (entry->clip_id[0] != '\0') {
+        snprintf(idempotency_header, sizeof(idempotency_header),
+                 "Idempotency-Key: %s\r\n", entry->clip_id);
+    }
+
     // Build HTTP headers (used by both TLS and plain paths)
     int hdr_len = snprintf(http_header, 1024,
         "POST %s HTTP/1.1\r\n"
         "Host: %s\r\n"
         "X-API-Key: %s\r\n"
+        "%s"
         "Content-Type: multipart/form-data; boundary=%s\r\n"
         "Content-Length: %zu\r\n"
         "Connection: close\r\n"
         "\r\n",
-        path, host, api_key_copy, BOUNDARY_STRING, content_length);
+        path, host, api_key_copy, idempotency_header,
+        BOUNDARY_STRING, content_length);
 
     // ---- TLS upload path ----
     if (use_tls) {
@@ -1065,6 +1075,10 @@ static upload_status_t do_upload(clip_queue_entry_t *entry) {
     esp_http_client_set_header(client, "X-API-Key", api_key_esp);
     // Clear api key from local copy now that it's been passed to HTTP client
     secure_clear(api_key_esp, sizeof(api_key_esp));
+    if (entry->clip_id[0] != '\0') {
+        // Stable clip ID lets the server deduplicate retried uploads
+        esp_http_client_set_header(client, "Idempotency-Key", entry->clip_id);
+    }
 
     char content_len_str[32];
     snprintf(content_len_str, sizeof(content_len_str), "%zu", content_length);